@@ -12,15 +12,29 @@ async function getApiBaseUrl() {
   }
 }
 
+async function getApiHeaders() {
+  const headers = {
+    'Content-Type': 'application/json',
+    'X-Client': `extension ${chrome.runtime.getManifest().version}`
+  };
+  try {
+    const result = await chrome.storage.sync.get(['apiToken']);
+    if (result.apiToken) {
+      headers['Authorization'] = `Bearer ${result.apiToken}`;
+    }
+  } catch (error) {
+    console.error('Error getting API token from storage:', error);
+  }
+  return headers;
+}
+
 async function saveBookmark(bookmarkData) {
   try {
     const apiBaseUrl = await getApiBaseUrl();
     const response = await fetch(`${apiBaseUrl}/bookmark`, {
       method: 'POST',
-      headers: {
-        'Content-Type': 'application/json',
-      },
-      body: JSON.stringify(bookmarkData)
+      headers: await getApiHeaders(),
+      body: JSON.stringify({ ...bookmarkData, source: 'extension' })
     });
     
     if (!response.ok) {
@@ -44,9 +58,7 @@ async function getTopics() {
     const apiBaseUrl = await getApiBaseUrl();
     const response = await fetch(`${apiBaseUrl}/topics`, {
       method: 'GET',
-      headers: {
-        'Content-Type': 'application/json',
-      }
+      headers: await getApiHeaders()
     });
     
     if (!response.ok) {
@@ -61,7 +73,7 @@ async function getTopics() {
   }
 }
 
-async function getBookmarkByUrl(url) {
+async function getBookmarkByUrl(url, canonicalUrl, title) {
   try {
     // Check cache first
     const cached = bookmarkCache.get(url);
@@ -71,12 +83,16 @@ async function getBookmarkByUrl(url) {
     }
     
     const apiBaseUrl = await getApiBaseUrl();
-    const encodedUrl = encodeURIComponent(url);
-    const response = await fetch(`${apiBaseUrl}/api/bookmark/by-url?url=${encodedUrl}`, {
+    const params = new URLSearchParams({ url });
+    if (canonicalUrl) {
+      params.set('canonical', canonicalUrl);
+    }
+    if (title) {
+      params.set('title', title);
+    }
+    const response = await fetch(`${apiBaseUrl}/api/bookmark/by-url?${params}`, {
       method: 'GET',
-      headers: {
-        'Content-Type': 'application/json',
-      }
+      headers: await getApiHeaders()
     });
     
     if (!response.ok) {
@@ -133,7 +149,7 @@ chrome.runtime.onMessage.addListener((request, sender, sendResponse) => {
       .catch(error => sendResponse({ success: false, error: error.message, topics: [] }));
     return true;
   } else if (request.action === 'getBookmarkByUrl') {
-    getBookmarkByUrl(request.url)
+    getBookmarkByUrl(request.url, request.canonicalUrl, request.title)
       .then(result => sendResponse(result))
       .catch(error => sendResponse({ success: false, error: error.message, found: false }));
     return true;
@@ -104,13 +104,19 @@ function getPageData() {
   // Extract domain for categorization
   const domain = new URL(url).hostname.replace('www.', '');
   
+  // Canonical URL lets the server match pages saved without tracking parameters
+  const canonical = document.querySelector('meta[property="og:url"]') ||
+                    document.querySelector('link[rel="canonical"]');
+  const canonicalUrl = canonical ? (canonical.getAttribute('content') || canonical.getAttribute('href') || '') : '';
+  
   return {
     url,
     title,
     description,
     content,
     metadata,
-    domain
+    domain,
+    canonicalUrl
   };
 }
 
@@ -1,5 +1,6 @@
 document.addEventListener('DOMContentLoaded', async () => {
   const apiUrlInput = document.getElementById('apiUrl');
+  const apiTokenInput = document.getElementById('apiToken');
   const saveBtn = document.getElementById('saveBtn');
   const statusDiv = document.getElementById('status');
   
@@ -15,7 +16,8 @@ document.addEventListener('DOMContentLoaded', async () => {
   
   // Load saved settings
   try {
-    const result = await chrome.storage.sync.get(['apiUrl']);
+    const result = await chrome.storage.sync.get(['apiUrl', 'apiToken']);
+    apiTokenInput.value = result.apiToken || '';
     if (result.apiUrl) {
       apiUrlInput.value = result.apiUrl;
     } else {
@@ -43,7 +45,7 @@ document.addEventListener('DOMContentLoaded', async () => {
       // Remove trailing slash
       const cleanUrl = apiUrl.replace(/\/$/, '');
       
-      await chrome.storage.sync.set({ apiUrl: cleanUrl });
+      await chrome.storage.sync.set({ apiUrl: cleanUrl, apiToken: apiTokenInput.value.trim() });
       showStatus('Settings saved successfully!');
     } catch (error) {
       if (error instanceof TypeError) {
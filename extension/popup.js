@@ -14,6 +14,7 @@ document.addEventListener('DOMContentLoaded', async () => {
   const propertyValueInput = document.getElementById('propertyValue');
   const addPropertyBtn = document.getElementById('addProperty');
   const propertiesList = document.getElementById('propertiesList');
+  const privateCheckbox = document.getElementById('private');
   const saveBtn = document.getElementById('saveBtn');
   const saveCloseBtn = document.getElementById('saveCloseBtn');
   const statusDiv = document.getElementById('status');
@@ -187,11 +188,13 @@ document.addEventListener('DOMContentLoaded', async () => {
   }
   
   // Check for existing bookmark
-  async function checkExistingBookmark(url) {
+  async function checkExistingBookmark(url, canonicalUrl, title) {
     try {
       const response = await chrome.runtime.sendMessage({
         action: 'getBookmarkByUrl',
-        url: url
+        url: url,
+        canonicalUrl: canonicalUrl,
+        title: title
       });
       
       if (response.success && response.found) {
@@ -212,6 +215,7 @@ document.addEventListener('DOMContentLoaded', async () => {
     actionSelect.value = bookmark.action || 'read-later';
     shareToInput.value = bookmark.shareTo || '';
     topicInput.value = bookmark.topic || '';
+    privateCheckbox.checked = !!bookmark.private;
     
     // Set tags
     tags = bookmark.tags || [];
@@ -310,7 +314,7 @@ document.addEventListener('DOMContentLoaded', async () => {
     urlInput.value = pageData.url;
     
     // Check for existing bookmark first
-    const existingBookmark = await checkExistingBookmark(pageData.url);
+    const existingBookmark = await checkExistingBookmark(pageData.url, pageData.canonicalUrl, pageData.title);
     
     if (existingBookmark) {
       // Use existing bookmark data
@@ -378,7 +382,9 @@ document.addEventListener('DOMContentLoaded', async () => {
         shareTo: action === 'share' ? shareTo : '',
         topic: action === 'working' ? topic : '',
         tags: tags,
-        customProperties: customProperties
+        customProperties: customProperties,
+        // Private bookmarks stay off public pages, feeds, digests and exports
+        private: privateCheckbox.checked
       };
       
       const response = await chrome.runtime.sendMessage({
@@ -387,7 +393,17 @@ document.addEventListener('DOMContentLoaded', async () => {
       });
       
       if (response.success) {
-        showStatus('Bookmark saved successfully!');
+        const saved = response.data || {};
+        const similar = saved.similar || [];
+        if (saved.updated) {
+          // The server returns 200 with "updated" when the URL was already saved
+          const savedBefore = saved.previouslySavedAt ? new Date(saved.previouslySavedAt) : null;
+          showStatus(savedBefore ? `Updated existing bookmark from ${savedBefore.getFullYear()}` : 'Updated existing bookmark');
+        } else if (similar.length > 0) {
+          showStatus(`Saved - you saved a similar page ${similar[0].age} ago: "${similar[0].title}"`);
+        } else {
+          showStatus('Bookmark saved successfully!');
+        }
         
         // Close tab if requested
         if (shouldCloseTab) {
@@ -71,6 +71,25 @@ export interface TriageBookmark {
   topic?: string
   tags?: string[]
   customProperties?: Record<string, string>
+  skipCount?: number
+  needsDecision?: boolean
+}
+
+export interface TriageSkipResponse {
+  bookmarkId: number
+  session: string
+  skipCount: number
+  needsDecision: boolean
+}
+
+export interface Citation {
+  authors: string[]
+  year?: number
+  venue?: string
+  doi?: string
+  arxivId?: string
+  source?: 'page' | 'manual'
+  updatedAt?: string
 }
 
 export interface TriageResponse {
@@ -141,15 +160,73 @@ class BookmarkService {
    * Get bookmarks needing triage
    * GET /api/bookmarks/triage
    */
-  async getTriageQueue(limit: number = 50, offset: number = 0): Promise<TriageResponse> {
-    const response = await apiClient.get<TriageResponse>('/api/bookmarks/triage', {
-      limit,
-      offset
-    })
+  async getTriageQueue(limit: number = 50, offset: number = 0, session?: string): Promise<TriageResponse> {
+    const params: Record<string, string | number> = { limit, offset }
+    if (session) {
+      params.session = session
+    }
+    const response = await apiClient.get<TriageResponse>('/api/bookmarks/triage', params)
     
     return response.data
   }
 
+  /**
+   * Get a random sample of the whole triage backlog
+   * GET /api/bookmarks/triage/random
+   */
+  async getTriageSample(count: number = 5): Promise<TriageResponse> {
+    const response = await apiClient.get<TriageResponse>('/api/bookmarks/triage/random', { count })
+    return response.data
+  }
+
+  /**
+   * Skip a bookmark for now; it moves to the end of this session's queue
+   * POST /api/triage/skip
+   */
+  async skipTriageBookmark(bookmarkId: number, session?: string): Promise<TriageSkipResponse> {
+    const response = await apiClient.post<TriageSkipResponse>('/api/triage/skip', { bookmarkId, session })
+    return response.data
+  }
+
+  /**
+   * Get a bookmark's citation metadata
+   * GET /api/bookmarks/{id}/citation
+   */
+  async getCitation(id: number): Promise<Citation> {
+    const response = await apiClient.get<Citation>(`/api/bookmarks/${id}/citation`)
+    return response.data
+  }
+
+  /**
+   * Get a bookmark's citation as a BibTeX entry
+   * GET /api/bookmarks/{id}/citation?format=bibtex
+   */
+  async getCitationBibTeX(id: number): Promise<string> {
+    const response = await apiClient.get<string>(`/api/bookmarks/${id}/citation`, {
+      params: { format: 'bibtex' },
+      responseType: 'text'
+    })
+    return response.data
+  }
+
+  /**
+   * Correct a bookmark's citation metadata by hand
+   * PUT /api/bookmarks/{id}/citation
+   */
+  async updateCitation(id: number, citation: Citation): Promise<Citation> {
+    const response = await apiClient.put<Citation>(`/api/bookmarks/${id}/citation`, citation)
+    return response.data
+  }
+
+  /**
+   * Extract citation metadata again from the bookmark's page
+   * POST /api/bookmarks/{id}/citation
+   */
+  async extractCitation(id: number): Promise<Citation> {
+    const response = await apiClient.post<Citation>(`/api/bookmarks/${id}/citation`)
+    return response.data
+  }
+
   /**
    * Get dashboard summary statistics
    * GET /api/stats/summary
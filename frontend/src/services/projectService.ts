@@ -36,6 +36,37 @@ export interface BackendProject {
   linkCount: number
   lastUpdated: string
   createdAt: string
+  aliases?: string[]
+}
+
+export interface ProjectEvent {
+  id: number
+  type: 'project_created' | 'status_changed' | 'bookmark_added' | 'bookmark_removed' | 'bookmark_linked' | 'bookmark_unlinked' | 'bookmark_deleted' | 'snapshot_created'
+  occurredAt: string
+  bookmarkId?: number
+  details: Record<string, string | number>
+}
+
+export interface ProjectActivityResponse {
+  projectId: number
+  events: ProjectEvent[]
+  nextBefore?: number
+}
+
+export interface BoardCard {
+  id: number
+  title: string
+  url: string
+  domain: string
+  tags?: string[]
+  thumbnailUrl?: string
+  linked?: boolean
+  position: number
+}
+
+export interface ProjectBoard {
+  projectId: number
+  columns: Array<{ action: string; cards: BoardCard[] }>
 }
 
 class ProjectService {
@@ -88,6 +119,66 @@ class ProjectService {
     return response.data
   }
 
+  /**
+   * Export a project's bookmarks as CSV, a Markdown table or BibTeX
+   * GET /api/projects/id/{id}/export?format=
+   */
+  async exportProject(id: number, format: 'csv' | 'markdown' | 'bibtex' = 'csv'): Promise<string> {
+    const response = await apiClient.get<string>(`/api/projects/id/${id}/export`, {
+      params: { format },
+      responseType: 'text'
+    })
+    return response.data
+  }
+
+  /**
+   * Get a project's activity feed, newest first
+   * GET /api/projects/{id}/activity
+   */
+  async getProjectActivity(id: number, before?: number, limit: number = 50): Promise<ProjectActivityResponse> {
+    const params: Record<string, number> = { limit }
+    if (before) {
+      params.before = before
+    }
+    const response = await apiClient.get<ProjectActivityResponse>(`/api/projects/${id}/activity`, params)
+    return response.data
+  }
+
+  /**
+   * Get a project's bookmarks as kanban columns
+   * GET /api/projects/{id}/board
+   */
+  async getProjectBoard(id: number): Promise<ProjectBoard> {
+    const response = await apiClient.get<ProjectBoard>(`/api/projects/${id}/board`)
+    return response.data
+  }
+
+  /**
+   * Move a card to a column and position, returning the updated board
+   * PATCH /api/projects/{id}/board/{bookmarkId}
+   */
+  async moveBoardCard(id: number, bookmarkId: number, column: BookmarkAction, position: number): Promise<ProjectBoard> {
+    const response = await apiClient.patch<ProjectBoard>(`/api/projects/${id}/board/${bookmarkId}`, { column, position })
+    return response.data
+  }
+
+  /**
+   * Add a topic that saves to the project, returning all its aliases
+   * POST /api/projects/{id}/aliases
+   */
+  async addProjectAlias(id: number, alias: string): Promise<string[]> {
+    const response = await apiClient.post<{ aliases: string[] }>(`/api/projects/${id}/aliases`, { alias })
+    return response.data.aliases
+  }
+
+  /**
+   * Remove a project alias
+   * DELETE /api/projects/{id}/aliases/{alias}
+   */
+  async removeProjectAlias(id: number, alias: string): Promise<void> {
+    await apiClient.delete(`/api/projects/${id}/aliases/${encodeURIComponent(alias)}`)
+  }
+
   /**
    * Transform backend project data to frontend Project interface
    */
@@ -101,7 +192,8 @@ class ProjectService {
       updated_at: backendProject.lastUpdated,
       linkCount: backendProject.linkCount,
       lastUpdated: backendProject.lastUpdated,
-      progress: this.calculateProgress(backendProject)
+      progress: this.calculateProgress(backendProject),
+      aliases: backendProject.aliases
     }
   }
 
@@ -34,7 +34,8 @@ export const useBookmarkStore = defineStore('bookmarks', () => {
     const results = bookmarks.value.filter(bookmark => 
       bookmark.title.toLowerCase().includes(searchTerm) ||
       bookmark.url.toLowerCase().includes(searchTerm) ||
-      (bookmark.description && bookmark.description.toLowerCase().includes(searchTerm))
+      (bookmark.description && bookmark.description.toLowerCase().includes(searchTerm)) ||
+      (bookmark.quote && bookmark.quote.toLowerCase().includes(searchTerm))
     )
     
     return results
@@ -65,7 +66,8 @@ export const useBookmarkStore = defineStore('bookmarks', () => {
       filtered = filtered.filter(bookmark => 
         bookmark.title.toLowerCase().includes(searchTerm) ||
         bookmark.url.toLowerCase().includes(searchTerm) ||
-        (bookmark.description && bookmark.description.toLowerCase().includes(searchTerm))
+        (bookmark.description && bookmark.description.toLowerCase().includes(searchTerm)) ||
+        (bookmark.quote && bookmark.quote.toLowerCase().includes(searchTerm))
       )
     }
 
@@ -6,6 +6,7 @@ export interface Bookmark {
   title: string
   description?: string
   content?: string
+  quote?: string
   action?: BookmarkAction
   shareTo?: string
   topic?: string
@@ -15,6 +16,34 @@ export interface Bookmark {
   age?: string
   tags?: string[]
   customProperties?: Record<string, string>
+  waybackUrl?: string
+  summary?: string
+  thumbnailUrl?: string
+  source?: string
+  attachments?: Attachment[]
+  relations?: BookmarkRelation[]
+}
+
+export interface Attachment {
+  id: number
+  bookmarkId: number
+  filename: string
+  contentType: string
+  size: number
+  sha256: string
+  createdAt: string
+  url: string
+}
+
+export interface BookmarkRelation {
+  id: number
+  type: 'depends-on' | 'follows' | 'refutes'
+  direction: 'outgoing' | 'incoming'
+  bookmarkId: number
+  title: string
+  url: string
+  note?: string
+  createdAt: string
 }
 
 export interface Project {
@@ -27,6 +56,9 @@ export interface Project {
   linkCount?: number
   lastUpdated?: string
   progress?: number
+  color?: string
+  coverUrl?: string
+  aliases?: string[]
 }
 
 export interface ProjectDetail {
@@ -36,6 +68,19 @@ export interface ProjectDetail {
   status: 'active' | 'stale' | 'inactive'
   progress?: number
   bookmarks: Bookmark[]
+  facets?: ProjectFacets
+}
+
+export interface FacetCount {
+  value: string
+  count: number
+}
+
+export interface ProjectFacets {
+  tags: FacetCount[]
+  domains: FacetCount[]
+  actions: FacetCount[]
+  years: FacetCount[]
 }
 
 export interface FilterState {
@@ -3,6 +3,12 @@ module bookminderapi
 go 1.25.5
 
 require (
+	filippo.io/age v1.0.0
 	github.com/golang-migrate/migrate/v4 v4.19.1
 	github.com/mattn/go-sqlite3 v1.14.42
 )
+
+require (
+	golang.org/x/crypto v0.45.0 // indirect
+	golang.org/x/sys v0.38.0 // indirect
+)
@@ -0,0 +1,38 @@
+//go:build ingest_example
+
+package main
+
+// An example compiled-in ingest processor. Build with -tags ingest_example to
+// include it; copy this file under another tag for site-specific enrichment.
+
+import (
+	"net/url"
+	"strings"
+)
+
+func init() {
+	registerIngestProcessor(ingestProcessor{
+		Name: "example-site",
+		// Record the site a bookmark came from so it can be filtered on
+		PreSave: func(req *BookmarkRequest) error {
+			parsed, err := url.Parse(req.URL)
+			if err != nil || parsed.Hostname() == "" {
+				return nil
+			}
+			if req.CustomProperties == nil {
+				req.CustomProperties = map[string]string{}
+			}
+			if _, ok := req.CustomProperties["site"]; !ok {
+				req.CustomProperties["site"] = strings.TrimPrefix(parsed.Hostname(), "www.")
+			}
+			return nil
+		},
+		// Internal documentation is worked on rather than read later
+		Suggest: func(domain, title, description string) (string, string, bool) {
+			if strings.HasSuffix(domain, ".internal") {
+				return "working", "domain=" + domain, true
+			}
+			return "", "", false
+		},
+	})
+}
@@ -0,0 +1,10 @@
+-- Remove sync change tracking
+DROP TRIGGER IF EXISTS bookmarks_sync_update;
+DROP TRIGGER IF EXISTS bookmarks_sync_insert;
+DROP INDEX IF EXISTS idx_bookmarks_rev;
+DROP INDEX IF EXISTS idx_bookmarks_uuid;
+DROP TABLE IF EXISTS sync_state;
+
+ALTER TABLE bookmarks DROP COLUMN updated_at;
+ALTER TABLE bookmarks DROP COLUMN rev;
+ALTER TABLE bookmarks DROP COLUMN uuid;
@@ -0,0 +1,52 @@
+-- Add change tracking for the offline sync API
+-- uuid: client-generated (or server-generated) stable identifier
+-- rev: global revision at which the row last changed, used by GET /api/sync?since=
+-- updated_at: wall-clock time of the last change, used for conflict resolution
+
+ALTER TABLE bookmarks ADD COLUMN uuid TEXT;
+ALTER TABLE bookmarks ADD COLUMN rev INTEGER DEFAULT 0;
+ALTER TABLE bookmarks ADD COLUMN updated_at DATETIME;
+
+CREATE TABLE IF NOT EXISTS sync_state (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    rev INTEGER NOT NULL DEFAULT 0
+);
+
+-- Backfill existing rows
+UPDATE bookmarks SET
+    uuid = lower(hex(randomblob(4))) || '-' || lower(hex(randomblob(2))) || '-4' ||
+           substr(lower(hex(randomblob(2))), 2) || '-' ||
+           substr('89ab', abs(random()) % 4 + 1, 1) || substr(lower(hex(randomblob(2))), 2) || '-' ||
+           lower(hex(randomblob(6))),
+    rev = id,
+    updated_at = timestamp
+WHERE uuid IS NULL;
+
+INSERT OR IGNORE INTO sync_state (id, rev) SELECT 1, COALESCE(MAX(rev), 0) FROM bookmarks;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bookmarks_uuid ON bookmarks(uuid);
+CREATE INDEX IF NOT EXISTS idx_bookmarks_rev ON bookmarks(rev);
+
+-- Every insert or update takes the next global revision
+CREATE TRIGGER IF NOT EXISTS bookmarks_sync_insert AFTER INSERT ON bookmarks
+BEGIN
+    UPDATE sync_state SET rev = rev + 1 WHERE id = 1;
+    UPDATE bookmarks SET
+        rev = (SELECT rev FROM sync_state WHERE id = 1),
+        updated_at = CURRENT_TIMESTAMP,
+        uuid = COALESCE(NEW.uuid,
+            lower(hex(randomblob(4))) || '-' || lower(hex(randomblob(2))) || '-4' ||
+            substr(lower(hex(randomblob(2))), 2) || '-' ||
+            substr('89ab', abs(random()) % 4 + 1, 1) || substr(lower(hex(randomblob(2))), 2) || '-' ||
+            lower(hex(randomblob(6))))
+    WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS bookmarks_sync_update AFTER UPDATE ON bookmarks
+BEGIN
+    UPDATE sync_state SET rev = rev + 1 WHERE id = 1;
+    UPDATE bookmarks SET
+        rev = (SELECT rev FROM sync_state WHERE id = 1),
+        updated_at = CURRENT_TIMESTAMP
+    WHERE id = NEW.id;
+END;
@@ -0,0 +1,2 @@
+-- Remove project version counter
+ALTER TABLE projects DROP COLUMN version;
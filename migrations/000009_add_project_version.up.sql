@@ -0,0 +1,3 @@
+-- Add a version counter to projects for optimistic concurrency on updates
+-- (bookmarks use their sync revision as the version)
+ALTER TABLE projects ADD COLUMN version INTEGER DEFAULT 1;
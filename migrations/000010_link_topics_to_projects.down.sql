@@ -0,0 +1,6 @@
+-- Remove topic/project link triggers; backfilled data is left in place
+DROP TRIGGER IF EXISTS projects_rename_topic;
+DROP TRIGGER IF EXISTS bookmarks_topic_derive_update;
+DROP TRIGGER IF EXISTS bookmarks_topic_derive_insert;
+DROP TRIGGER IF EXISTS bookmarks_topic_resolve_update;
+DROP TRIGGER IF EXISTS bookmarks_topic_resolve_insert;
@@ -0,0 +1,66 @@
+-- Make project_id the single source of truth for a bookmark's project.
+-- topic is kept as a derived copy of the project name for legacy clients.
+
+-- Resolve any topics written since migration 4 that never got a project
+INSERT OR IGNORE INTO projects (name, description, status, created_at, updated_at)
+SELECT DISTINCT
+    topic,
+    'Auto-created for topic: ' || topic,
+    'active',
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP
+FROM bookmarks
+WHERE topic IS NOT NULL AND topic != '' AND project_id IS NULL;
+
+UPDATE bookmarks
+SET project_id = (SELECT p.id FROM projects p WHERE p.name = bookmarks.topic)
+WHERE topic IS NOT NULL AND topic != '' AND project_id IS NULL;
+
+-- Drop links to projects that no longer exist
+UPDATE bookmarks
+SET project_id = NULL, topic = ''
+WHERE project_id IS NOT NULL AND project_id NOT IN (SELECT id FROM projects);
+
+-- Re-derive topic from the linked project
+UPDATE bookmarks
+SET topic = (SELECT p.name FROM projects p WHERE p.id = bookmarks.project_id)
+WHERE project_id IS NOT NULL
+  AND topic IS NOT (SELECT p.name FROM projects p WHERE p.id = bookmarks.project_id);
+
+-- Legacy writers that only set topic get a project resolved for them
+CREATE TRIGGER IF NOT EXISTS bookmarks_topic_resolve_insert AFTER INSERT ON bookmarks
+WHEN NEW.project_id IS NULL AND NEW.topic IS NOT NULL AND NEW.topic != ''
+BEGIN
+    INSERT OR IGNORE INTO projects (name, description, status, created_at, updated_at)
+    VALUES (NEW.topic, 'Auto-created for topic: ' || NEW.topic, 'active', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+    UPDATE bookmarks SET project_id = (SELECT id FROM projects WHERE name = NEW.topic) WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS bookmarks_topic_resolve_update AFTER UPDATE OF topic, project_id ON bookmarks
+WHEN NEW.project_id IS NULL AND NEW.topic IS NOT NULL AND NEW.topic != ''
+BEGIN
+    INSERT OR IGNORE INTO projects (name, description, status, created_at, updated_at)
+    VALUES (NEW.topic, 'Auto-created for topic: ' || NEW.topic, 'active', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+    UPDATE bookmarks SET project_id = (SELECT id FROM projects WHERE name = NEW.topic) WHERE id = NEW.id;
+END;
+
+-- topic always mirrors the linked project's name
+CREATE TRIGGER IF NOT EXISTS bookmarks_topic_derive_insert AFTER INSERT ON bookmarks
+WHEN NEW.project_id IS NOT NULL
+BEGIN
+    UPDATE bookmarks SET topic = (SELECT name FROM projects WHERE id = NEW.project_id)
+    WHERE id = NEW.id AND topic IS NOT (SELECT name FROM projects WHERE id = NEW.project_id);
+END;
+
+CREATE TRIGGER IF NOT EXISTS bookmarks_topic_derive_update AFTER UPDATE OF project_id ON bookmarks
+WHEN NEW.project_id IS NOT NULL
+BEGIN
+    UPDATE bookmarks SET topic = (SELECT name FROM projects WHERE id = NEW.project_id)
+    WHERE id = NEW.id AND topic IS NOT (SELECT name FROM projects WHERE id = NEW.project_id);
+END;
+
+CREATE TRIGGER IF NOT EXISTS projects_rename_topic AFTER UPDATE OF name ON projects
+WHEN NEW.name IS NOT OLD.name
+BEGIN
+    UPDATE bookmarks SET topic = NEW.name WHERE project_id = NEW.id;
+END;
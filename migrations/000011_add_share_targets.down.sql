@@ -0,0 +1,3 @@
+-- Remove share targets
+DROP INDEX IF EXISTS idx_bookmarks_shareto;
+DROP TABLE IF EXISTS share_targets;
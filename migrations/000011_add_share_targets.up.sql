@@ -0,0 +1,11 @@
+-- Named destinations that bookmark.shareTo can reference
+CREATE TABLE IF NOT EXISTS share_targets (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    type TEXT NOT NULL DEFAULT 'other',
+    config TEXT DEFAULT '{}',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookmarks_shareto ON bookmarks(shareTo);
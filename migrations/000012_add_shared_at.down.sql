@@ -0,0 +1,2 @@
+-- Remove shared_at
+ALTER TABLE bookmarks DROP COLUMN shared_at;
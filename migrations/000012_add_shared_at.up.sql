@@ -0,0 +1,2 @@
+-- Record when a bookmark was published from the share queue
+ALTER TABLE bookmarks ADD COLUMN shared_at DATETIME;
@@ -0,0 +1,5 @@
+-- Remove project soft delete
+DROP INDEX IF EXISTS idx_bookmarks_trashed_project_id;
+DROP INDEX IF EXISTS idx_projects_deleted_at;
+ALTER TABLE bookmarks DROP COLUMN trashed_project_id;
+ALTER TABLE projects DROP COLUMN deleted_at;
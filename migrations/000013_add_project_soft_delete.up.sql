@@ -0,0 +1,7 @@
+-- Soft delete for projects: deleted_at marks a project as trashed and
+-- trashed_project_id remembers which trashed project a bookmark belonged to
+ALTER TABLE projects ADD COLUMN deleted_at DATETIME;
+ALTER TABLE bookmarks ADD COLUMN trashed_project_id INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_projects_deleted_at ON projects(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_bookmarks_trashed_project_id ON bookmarks(trashed_project_id);
@@ -0,0 +1,2 @@
+-- Remove bookmark URL index
+DROP INDEX IF EXISTS idx_bookmarks_url;
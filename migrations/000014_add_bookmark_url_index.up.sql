@@ -0,0 +1,2 @@
+-- Existence checks look bookmarks up by URL on every page load
+CREATE INDEX IF NOT EXISTS idx_bookmarks_url ON bookmarks(url);
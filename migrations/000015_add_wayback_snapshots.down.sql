@@ -0,0 +1,3 @@
+-- Remove Internet Archive snapshots
+ALTER TABLE bookmarks DROP COLUMN wayback_at;
+ALTER TABLE bookmarks DROP COLUMN wayback_url;
@@ -0,0 +1,3 @@
+-- Internet Archive snapshot of the bookmarked page, kept as a fallback link
+ALTER TABLE bookmarks ADD COLUMN wayback_url TEXT;
+ALTER TABLE bookmarks ADD COLUMN wayback_at DATETIME;
@@ -0,0 +1,2 @@
+-- Remove generated summaries
+ALTER TABLE bookmarks DROP COLUMN summary;
@@ -0,0 +1,2 @@
+-- Generated summary of a bookmark's content
+ALTER TABLE bookmarks ADD COLUMN summary TEXT;
@@ -0,0 +1,2 @@
+-- Remove project snapshots
+DROP TABLE IF EXISTS project_snapshots;
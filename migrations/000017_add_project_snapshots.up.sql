@@ -0,0 +1,10 @@
+-- Named, immutable copies of a project's bookmark list
+CREATE TABLE IF NOT EXISTS project_snapshots (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id INTEGER NOT NULL REFERENCES projects(id),
+    name TEXT NOT NULL,
+    bookmarks TEXT NOT NULL DEFAULT '[]',
+    bookmark_count INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (project_id, name)
+);
@@ -0,0 +1,2 @@
+-- Remove scoped API tokens
+DROP TABLE IF EXISTS api_tokens;
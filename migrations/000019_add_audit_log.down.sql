@@ -0,0 +1,4 @@
+-- Remove the audit trail
+DROP INDEX IF EXISTS idx_audit_log_occurred_at;
+DROP INDEX IF EXISTS idx_audit_log_action;
+DROP TABLE IF EXISTS audit_log;
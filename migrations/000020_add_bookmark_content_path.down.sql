@@ -0,0 +1,2 @@
+-- Remove the on-disk content reference
+ALTER TABLE bookmarks DROP COLUMN content_path;
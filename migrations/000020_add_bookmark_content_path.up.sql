@@ -0,0 +1,2 @@
+-- Full page content kept on disk when it is over the stored content limit
+ALTER TABLE bookmarks ADD COLUMN content_path TEXT;
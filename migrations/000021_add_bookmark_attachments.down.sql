@@ -0,0 +1,3 @@
+-- Remove bookmark attachments
+DROP INDEX IF EXISTS idx_bookmark_attachments_bookmark_id;
+DROP TABLE IF EXISTS bookmark_attachments;
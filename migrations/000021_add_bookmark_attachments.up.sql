@@ -0,0 +1,13 @@
+-- Files attached to bookmarks; the bytes live in the blob store
+CREATE TABLE IF NOT EXISTS bookmark_attachments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    bookmark_id INTEGER NOT NULL REFERENCES bookmarks(id),
+    filename TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size INTEGER NOT NULL,
+    sha256 TEXT NOT NULL,
+    blob_key TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookmark_attachments_bookmark_id ON bookmark_attachments(bookmark_id);
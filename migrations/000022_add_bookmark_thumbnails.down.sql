@@ -0,0 +1,3 @@
+-- Remove screenshot thumbnails
+ALTER TABLE bookmarks DROP COLUMN thumbnail_type;
+ALTER TABLE bookmarks DROP COLUMN thumbnail_key;
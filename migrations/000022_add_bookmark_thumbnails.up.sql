@@ -0,0 +1,3 @@
+-- Screenshot thumbnail per bookmark; the image lives in the blob store
+ALTER TABLE bookmarks ADD COLUMN thumbnail_key TEXT;
+ALTER TABLE bookmarks ADD COLUMN thumbnail_type TEXT;
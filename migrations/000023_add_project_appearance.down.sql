@@ -0,0 +1,4 @@
+-- Remove project colors and cover images
+ALTER TABLE projects DROP COLUMN cover_type;
+ALTER TABLE projects DROP COLUMN cover_key;
+ALTER TABLE projects DROP COLUMN color;
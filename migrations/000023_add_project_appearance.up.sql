@@ -0,0 +1,4 @@
+-- Per-project color and cover image; the image lives in the blob store
+ALTER TABLE projects ADD COLUMN color TEXT;
+ALTER TABLE projects ADD COLUMN cover_key TEXT;
+ALTER TABLE projects ADD COLUMN cover_type TEXT;
@@ -0,0 +1,4 @@
+-- Remove the triage aging log
+DROP INDEX IF EXISTS idx_triage_aging_runs_ran_at;
+DROP TABLE IF EXISTS triage_aging_items;
+DROP TABLE IF EXISTS triage_aging_runs;
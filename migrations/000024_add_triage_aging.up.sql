@@ -0,0 +1,21 @@
+-- Runs of the triage aging policy and the bookmarks each run changed, kept for undo
+CREATE TABLE IF NOT EXISTS triage_aging_runs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    ran_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    actor TEXT NOT NULL,
+    mode TEXT NOT NULL CHECK (mode IN ('archive', 'tag')),
+    tag TEXT NOT NULL DEFAULT '',
+    max_age_days INTEGER NOT NULL,
+    affected INTEGER NOT NULL DEFAULT 0,
+    undone_at DATETIME
+);
+
+CREATE TABLE IF NOT EXISTS triage_aging_items (
+    run_id INTEGER NOT NULL REFERENCES triage_aging_runs(id) ON DELETE CASCADE,
+    bookmark_id INTEGER NOT NULL REFERENCES bookmarks(id),
+    previous_action TEXT,
+    previous_tags TEXT,
+    PRIMARY KEY (run_id, bookmark_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_triage_aging_runs_ran_at ON triage_aging_runs(ran_at);
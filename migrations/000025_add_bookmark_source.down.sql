@@ -0,0 +1,3 @@
+-- Remove bookmark sources
+DROP INDEX IF EXISTS idx_bookmarks_source;
+ALTER TABLE bookmarks DROP COLUMN source;
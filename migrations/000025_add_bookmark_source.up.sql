@@ -0,0 +1,4 @@
+-- Where each bookmark was saved from (extension, bookmarklet, api, import:<service>, sync)
+ALTER TABLE bookmarks ADD COLUMN source TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_bookmarks_source ON bookmarks(source);
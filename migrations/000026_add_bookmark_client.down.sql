@@ -0,0 +1,2 @@
+-- Remove bookmark clients
+ALTER TABLE bookmarks DROP COLUMN client;
@@ -0,0 +1,2 @@
+-- The X-Client header of the client that last saved each bookmark
+ALTER TABLE bookmarks ADD COLUMN client TEXT;
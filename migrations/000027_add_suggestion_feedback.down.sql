@@ -0,0 +1,3 @@
+-- Remove the suggestion feedback log
+DROP INDEX IF EXISTS idx_suggestion_feedback_created_at;
+DROP TABLE IF EXISTS suggestion_feedback;
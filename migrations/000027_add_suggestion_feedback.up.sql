@@ -0,0 +1,12 @@
+-- Triage decisions compared with the action that was suggested at the time
+CREATE TABLE IF NOT EXISTS suggestion_feedback (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    bookmark_id INTEGER NOT NULL REFERENCES bookmarks(id),
+    suggested TEXT NOT NULL,
+    rule TEXT NOT NULL DEFAULT '',
+    chosen TEXT NOT NULL,
+    accepted BOOLEAN NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_suggestion_feedback_created_at ON suggestion_feedback(created_at);
@@ -0,0 +1,2 @@
+-- Remove the stored triage classifier
+DROP TABLE IF EXISTS suggestion_model;
@@ -0,0 +1,7 @@
+-- The trained triage classifier, a single row replaced on each training run
+CREATE TABLE IF NOT EXISTS suggestion_model (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    trained_at DATETIME NOT NULL,
+    examples INTEGER NOT NULL,
+    model TEXT NOT NULL
+);
@@ -0,0 +1,9 @@
+-- Remove the project activity feed
+DROP TRIGGER IF EXISTS project_events_snapshot;
+DROP TRIGGER IF EXISTS project_events_bookmark_delete;
+DROP TRIGGER IF EXISTS project_events_bookmark_move;
+DROP TRIGGER IF EXISTS project_events_bookmark_insert;
+DROP TRIGGER IF EXISTS project_events_status;
+DROP TRIGGER IF EXISTS project_events_created;
+DROP INDEX IF EXISTS idx_project_events_project;
+DROP TABLE IF EXISTS project_events;
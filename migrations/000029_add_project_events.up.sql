@@ -0,0 +1,64 @@
+-- Project history for the activity feed, written by triggers so every write path is covered
+CREATE TABLE IF NOT EXISTS project_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id INTEGER NOT NULL,
+    type TEXT NOT NULL,
+    bookmark_id INTEGER,
+    details TEXT NOT NULL DEFAULT '{}',
+    occurred_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_events_project ON project_events(project_id, id);
+
+-- Existing projects and their bookmarks start the feed
+INSERT INTO project_events (project_id, type, details, occurred_at)
+SELECT id, 'project_created', json_object('name', name), COALESCE(created_at, CURRENT_TIMESTAMP) FROM projects;
+
+INSERT INTO project_events (project_id, type, bookmark_id, details, occurred_at)
+SELECT project_id, 'bookmark_added', id, json_object('title', title, 'url', url), COALESCE(timestamp, CURRENT_TIMESTAMP)
+FROM bookmarks
+WHERE project_id IS NOT NULL AND (deleted = FALSE OR deleted IS NULL)
+ORDER BY timestamp, id;
+
+CREATE TRIGGER IF NOT EXISTS project_events_created AFTER INSERT ON projects
+BEGIN
+    INSERT INTO project_events (project_id, type, details) VALUES (NEW.id, 'project_created', json_object('name', NEW.name));
+END;
+
+CREATE TRIGGER IF NOT EXISTS project_events_status AFTER UPDATE OF status ON projects
+WHEN NEW.status IS NOT OLD.status
+BEGIN
+    INSERT INTO project_events (project_id, type, details)
+    VALUES (NEW.id, 'status_changed', json_object('from', OLD.status, 'to', NEW.status));
+END;
+
+CREATE TRIGGER IF NOT EXISTS project_events_bookmark_insert AFTER INSERT ON bookmarks
+WHEN NEW.project_id IS NOT NULL
+BEGIN
+    INSERT INTO project_events (project_id, type, bookmark_id, details)
+    VALUES (NEW.project_id, 'bookmark_added', NEW.id, json_object('title', NEW.title, 'url', NEW.url));
+END;
+
+CREATE TRIGGER IF NOT EXISTS project_events_bookmark_move AFTER UPDATE OF project_id ON bookmarks
+WHEN NEW.project_id IS NOT OLD.project_id
+BEGIN
+    INSERT INTO project_events (project_id, type, bookmark_id, details)
+    SELECT OLD.project_id, 'bookmark_removed', NEW.id, json_object('title', NEW.title, 'url', NEW.url)
+    WHERE OLD.project_id IS NOT NULL;
+    INSERT INTO project_events (project_id, type, bookmark_id, details)
+    SELECT NEW.project_id, 'bookmark_added', NEW.id, json_object('title', NEW.title, 'url', NEW.url)
+    WHERE NEW.project_id IS NOT NULL;
+END;
+
+CREATE TRIGGER IF NOT EXISTS project_events_bookmark_delete AFTER UPDATE OF deleted ON bookmarks
+WHEN NEW.deleted AND NOT COALESCE(OLD.deleted, FALSE) AND NEW.project_id IS NOT NULL
+BEGIN
+    INSERT INTO project_events (project_id, type, bookmark_id, details)
+    VALUES (NEW.project_id, 'bookmark_deleted', NEW.id, json_object('title', NEW.title, 'url', NEW.url));
+END;
+
+CREATE TRIGGER IF NOT EXISTS project_events_snapshot AFTER INSERT ON project_snapshots
+BEGIN
+    INSERT INTO project_events (project_id, type, details)
+    VALUES (NEW.project_id, 'snapshot_created', json_object('name', NEW.name, 'bookmarks', NEW.bookmark_count));
+END;
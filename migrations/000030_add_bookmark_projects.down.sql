@@ -0,0 +1,6 @@
+-- Remove additional bookmark projects
+DROP TRIGGER IF EXISTS project_events_bookmark_unlink;
+DROP TRIGGER IF EXISTS project_events_bookmark_link;
+DROP TRIGGER IF EXISTS bookmark_projects_primary;
+DROP INDEX IF EXISTS idx_bookmark_projects_project;
+DROP TABLE IF EXISTS bookmark_projects;
@@ -0,0 +1,28 @@
+-- Additional projects a bookmark belongs to; bookmarks.project_id stays the primary one
+CREATE TABLE IF NOT EXISTS bookmark_projects (
+    bookmark_id INTEGER NOT NULL REFERENCES bookmarks(id),
+    project_id INTEGER NOT NULL REFERENCES projects(id),
+    added_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (bookmark_id, project_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookmark_projects_project ON bookmark_projects(project_id);
+
+-- A project that becomes the primary one is no longer also an additional one
+CREATE TRIGGER IF NOT EXISTS bookmark_projects_primary AFTER UPDATE OF project_id ON bookmarks
+WHEN NEW.project_id IS NOT NULL
+BEGIN
+    DELETE FROM bookmark_projects WHERE bookmark_id = NEW.id AND project_id = NEW.project_id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS project_events_bookmark_link AFTER INSERT ON bookmark_projects
+BEGIN
+    INSERT INTO project_events (project_id, type, bookmark_id, details)
+    SELECT NEW.project_id, 'bookmark_linked', b.id, json_object('title', b.title, 'url', b.url) FROM bookmarks b WHERE b.id = NEW.bookmark_id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS project_events_bookmark_unlink AFTER DELETE ON bookmark_projects
+BEGIN
+    INSERT INTO project_events (project_id, type, bookmark_id, details)
+    SELECT OLD.project_id, 'bookmark_unlinked', b.id, json_object('title', b.title, 'url', b.url) FROM bookmarks b WHERE b.id = OLD.bookmark_id;
+END;
@@ -0,0 +1,3 @@
+-- Remove bookmark relations
+DROP INDEX IF EXISTS idx_bookmark_relations_target;
+DROP TABLE IF EXISTS bookmark_relations;
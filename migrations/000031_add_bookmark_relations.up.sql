@@ -0,0 +1,13 @@
+-- Typed links between bookmarks: source <type> target, e.g. part 2 follows part 1
+CREATE TABLE IF NOT EXISTS bookmark_relations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    source_id INTEGER NOT NULL REFERENCES bookmarks(id),
+    target_id INTEGER NOT NULL REFERENCES bookmarks(id),
+    type TEXT NOT NULL CHECK (type IN ('depends-on', 'follows', 'refutes')),
+    note TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    CHECK (source_id != target_id),
+    UNIQUE (source_id, target_id, type)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookmark_relations_target ON bookmark_relations(target_id);
@@ -0,0 +1,2 @@
+-- Remove kanban board positions
+DROP TABLE IF EXISTS board_positions;
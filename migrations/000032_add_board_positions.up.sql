@@ -0,0 +1,7 @@
+-- Card order on project kanban boards; the column is the bookmark's action
+CREATE TABLE IF NOT EXISTS board_positions (
+    project_id INTEGER NOT NULL REFERENCES projects(id),
+    bookmark_id INTEGER NOT NULL REFERENCES bookmarks(id),
+    position INTEGER NOT NULL,
+    PRIMARY KEY (project_id, bookmark_id)
+);
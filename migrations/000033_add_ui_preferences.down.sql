@@ -0,0 +1,2 @@
+-- Remove stored UI preferences
+DROP TABLE IF EXISTS ui_preferences;
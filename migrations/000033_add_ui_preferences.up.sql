@@ -0,0 +1,6 @@
+-- Frontend settings kept server-side so they follow the user between browsers
+CREATE TABLE IF NOT EXISTS ui_preferences (
+    profile TEXT PRIMARY KEY,
+    preferences TEXT NOT NULL DEFAULT '{}',
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
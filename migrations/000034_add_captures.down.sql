@@ -0,0 +1,3 @@
+-- Remove the quick-capture inbox
+DROP INDEX IF EXISTS idx_captures_inbox;
+DROP TABLE IF EXISTS captures;
@@ -0,0 +1,12 @@
+-- Quick-capture inbox for scraps that don't have a link yet
+CREATE TABLE IF NOT EXISTS captures (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    text TEXT NOT NULL,
+    url TEXT,
+    tags TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    promoted_bookmark_id INTEGER REFERENCES bookmarks(id),
+    promoted_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_captures_inbox ON captures(promoted_at, created_at);
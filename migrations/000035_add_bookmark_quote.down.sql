@@ -0,0 +1,2 @@
+-- Remove bookmark quotes
+ALTER TABLE bookmarks DROP COLUMN quote;
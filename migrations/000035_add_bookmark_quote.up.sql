@@ -0,0 +1,2 @@
+-- Text highlighted on the page when it was saved, kept apart from the page content
+ALTER TABLE bookmarks ADD COLUMN quote TEXT;
@@ -0,0 +1,21 @@
+-- Merged projects are not split again; only the constraint and triggers are reverted
+DROP INDEX IF EXISTS idx_projects_name_nocase;
+
+DROP TRIGGER IF EXISTS bookmarks_topic_resolve_insert;
+DROP TRIGGER IF EXISTS bookmarks_topic_resolve_update;
+
+CREATE TRIGGER IF NOT EXISTS bookmarks_topic_resolve_insert AFTER INSERT ON bookmarks
+WHEN NEW.project_id IS NULL AND NEW.topic IS NOT NULL AND NEW.topic != ''
+BEGIN
+    INSERT OR IGNORE INTO projects (name, description, status, created_at, updated_at)
+    VALUES (NEW.topic, 'Auto-created for topic: ' || NEW.topic, 'active', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+    UPDATE bookmarks SET project_id = (SELECT id FROM projects WHERE name = NEW.topic) WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS bookmarks_topic_resolve_update AFTER UPDATE OF topic, project_id ON bookmarks
+WHEN NEW.project_id IS NULL AND NEW.topic IS NOT NULL AND NEW.topic != ''
+BEGIN
+    INSERT OR IGNORE INTO projects (name, description, status, created_at, updated_at)
+    VALUES (NEW.topic, 'Auto-created for topic: ' || NEW.topic, 'active', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+    UPDATE bookmarks SET project_id = (SELECT id FROM projects WHERE name = NEW.topic) WHERE id = NEW.id;
+END;
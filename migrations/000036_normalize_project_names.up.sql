@@ -0,0 +1,101 @@
+-- Project names are matched ignoring case and extra whitespace, so "AI", "ai"
+-- and " ai " are one project. Existing duplicates are merged into the oldest
+-- live project of each group before the constraint is added.
+CREATE TABLE project_name_merges (
+    duplicate_id INTEGER PRIMARY KEY,
+    survivor_id INTEGER NOT NULL
+);
+
+CREATE TABLE project_name_keys AS
+SELECT id, lower(trim(replace(replace(replace(replace(replace(replace(replace(replace(name, char(9), ' '), char(10), ' '), char(13), ' '), '  ', ' '), '  ', ' '), '  ', ' '), '  ', ' '), '  ', ' '))) AS name_key, deleted_at IS NOT NULL AS trashed
+FROM projects;
+
+INSERT INTO project_name_merges (duplicate_id, survivor_id)
+SELECT k.id, (SELECT s.id FROM project_name_keys s WHERE s.name_key = k.name_key ORDER BY s.trashed, s.id LIMIT 1)
+FROM project_name_keys k;
+
+DROP TABLE project_name_keys;
+
+DELETE FROM project_name_merges WHERE duplicate_id = survivor_id;
+
+-- History moves to the surviving project; each duplicate's creation is dropped
+DELETE FROM project_events
+WHERE type = 'project_created' AND project_id IN (SELECT duplicate_id FROM project_name_merges);
+UPDATE project_events
+SET project_id = (SELECT survivor_id FROM project_name_merges WHERE duplicate_id = project_events.project_id)
+WHERE project_id IN (SELECT duplicate_id FROM project_name_merges);
+
+UPDATE bookmarks
+SET project_id = (SELECT survivor_id FROM project_name_merges WHERE duplicate_id = bookmarks.project_id)
+WHERE project_id IN (SELECT duplicate_id FROM project_name_merges);
+
+-- Bookmarks of a trashed duplicate follow the survivor, into it if it is live
+UPDATE bookmarks
+SET trashed_project_id = (SELECT survivor_id FROM project_name_merges WHERE duplicate_id = bookmarks.trashed_project_id)
+WHERE trashed_project_id IN (SELECT duplicate_id FROM project_name_merges);
+UPDATE bookmarks
+SET project_id = trashed_project_id, trashed_project_id = NULL
+WHERE trashed_project_id IN (SELECT id FROM projects WHERE deleted_at IS NULL)
+  AND (deleted = FALSE OR deleted IS NULL);
+
+UPDATE OR IGNORE bookmark_projects
+SET project_id = (SELECT survivor_id FROM project_name_merges WHERE duplicate_id = bookmark_projects.project_id)
+WHERE project_id IN (SELECT duplicate_id FROM project_name_merges);
+DELETE FROM bookmark_projects
+WHERE project_id IN (SELECT duplicate_id FROM project_name_merges)
+   OR project_id = (SELECT b.project_id FROM bookmarks b WHERE b.id = bookmark_projects.bookmark_id);
+
+UPDATE OR IGNORE board_positions
+SET project_id = (SELECT survivor_id FROM project_name_merges WHERE duplicate_id = board_positions.project_id)
+WHERE project_id IN (SELECT duplicate_id FROM project_name_merges);
+DELETE FROM board_positions WHERE project_id IN (SELECT duplicate_id FROM project_name_merges);
+
+-- Snapshots keep their names unless the survivor has one with the same name
+UPDATE OR IGNORE project_snapshots
+SET project_id = (SELECT survivor_id FROM project_name_merges WHERE duplicate_id = project_snapshots.project_id)
+WHERE project_id IN (SELECT duplicate_id FROM project_name_merges);
+UPDATE project_snapshots
+SET name = name || ' (' || (SELECT p.name FROM projects p WHERE p.id = project_snapshots.project_id) || ')',
+    project_id = (SELECT survivor_id FROM project_name_merges WHERE duplicate_id = project_snapshots.project_id)
+WHERE project_id IN (SELECT duplicate_id FROM project_name_merges);
+
+UPDATE api_tokens
+SET project_id = (SELECT survivor_id FROM project_name_merges WHERE duplicate_id = api_tokens.project_id)
+WHERE project_id IN (SELECT duplicate_id FROM project_name_merges);
+
+UPDATE ui_preferences
+SET preferences = json_set(preferences, '$.defaultProjectId',
+    (SELECT survivor_id FROM project_name_merges WHERE duplicate_id = json_extract(preferences, '$.defaultProjectId')))
+WHERE json_valid(preferences)
+  AND json_extract(preferences, '$.defaultProjectId') IN (SELECT duplicate_id FROM project_name_merges);
+
+DELETE FROM projects WHERE id IN (SELECT duplicate_id FROM project_name_merges);
+DELETE FROM project_events WHERE project_id IN (SELECT duplicate_id FROM project_name_merges);
+
+DROP TABLE project_name_merges;
+
+-- Surviving names keep their case but lose surrounding and repeated whitespace
+UPDATE projects SET name = trim(replace(replace(replace(replace(replace(replace(replace(replace(name, char(9), ' '), char(10), ' '), char(13), ' '), '  ', ' '), '  ', ' '), '  ', ' '), '  ', ' '), '  ', ' '))
+WHERE name IS NOT trim(replace(replace(replace(replace(replace(replace(replace(replace(name, char(9), ' '), char(10), ' '), char(13), ' '), '  ', ' '), '  ', ' '), '  ', ' '), '  ', ' '), '  ', ' '));
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_name_nocase ON projects(name COLLATE NOCASE);
+
+-- Legacy writers that only set topic resolve to the project regardless of case
+DROP TRIGGER IF EXISTS bookmarks_topic_resolve_insert;
+DROP TRIGGER IF EXISTS bookmarks_topic_resolve_update;
+
+CREATE TRIGGER IF NOT EXISTS bookmarks_topic_resolve_insert AFTER INSERT ON bookmarks
+WHEN NEW.project_id IS NULL AND NEW.topic IS NOT NULL AND trim(NEW.topic) != ''
+BEGIN
+    INSERT OR IGNORE INTO projects (name, description, status, created_at, updated_at)
+    VALUES (trim(NEW.topic), 'Auto-created for topic: ' || trim(NEW.topic), 'active', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+    UPDATE bookmarks SET project_id = (SELECT id FROM projects WHERE name = trim(NEW.topic) COLLATE NOCASE) WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS bookmarks_topic_resolve_update AFTER UPDATE OF topic, project_id ON bookmarks
+WHEN NEW.project_id IS NULL AND NEW.topic IS NOT NULL AND trim(NEW.topic) != ''
+BEGIN
+    INSERT OR IGNORE INTO projects (name, description, status, created_at, updated_at)
+    VALUES (trim(NEW.topic), 'Auto-created for topic: ' || trim(NEW.topic), 'active', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+    UPDATE bookmarks SET project_id = (SELECT id FROM projects WHERE name = trim(NEW.topic) COLLATE NOCASE) WHERE id = NEW.id;
+END;
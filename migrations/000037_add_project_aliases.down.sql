@@ -0,0 +1,23 @@
+-- Drop project aliases and restore the topic triggers from migration 000036
+DROP TRIGGER IF EXISTS projects_claim_alias;
+DROP TRIGGER IF EXISTS projects_rename_alias;
+DROP TABLE IF EXISTS project_aliases;
+
+DROP TRIGGER IF EXISTS bookmarks_topic_resolve_insert;
+DROP TRIGGER IF EXISTS bookmarks_topic_resolve_update;
+
+CREATE TRIGGER IF NOT EXISTS bookmarks_topic_resolve_insert AFTER INSERT ON bookmarks
+WHEN NEW.project_id IS NULL AND NEW.topic IS NOT NULL AND trim(NEW.topic) != ''
+BEGIN
+    INSERT OR IGNORE INTO projects (name, description, status, created_at, updated_at)
+    VALUES (trim(NEW.topic), 'Auto-created for topic: ' || trim(NEW.topic), 'active', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+    UPDATE bookmarks SET project_id = (SELECT id FROM projects WHERE name = trim(NEW.topic) COLLATE NOCASE) WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS bookmarks_topic_resolve_update AFTER UPDATE OF topic, project_id ON bookmarks
+WHEN NEW.project_id IS NULL AND NEW.topic IS NOT NULL AND trim(NEW.topic) != ''
+BEGIN
+    INSERT OR IGNORE INTO projects (name, description, status, created_at, updated_at)
+    VALUES (trim(NEW.topic), 'Auto-created for topic: ' || trim(NEW.topic), 'active', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+    UPDATE bookmarks SET project_id = (SELECT id FROM projects WHERE name = trim(NEW.topic) COLLATE NOCASE) WHERE id = NEW.id;
+END;
@@ -0,0 +1,50 @@
+-- Former project names, so saves to an old topic land in the renamed project
+CREATE TABLE IF NOT EXISTS project_aliases (
+    alias TEXT PRIMARY KEY COLLATE NOCASE,
+    project_id INTEGER NOT NULL REFERENCES projects(id),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_aliases_project ON project_aliases(project_id);
+
+-- Every rename records the old name, whichever write path made it; a name in
+-- use by a project is never also an alias
+CREATE TRIGGER IF NOT EXISTS projects_rename_alias AFTER UPDATE OF name ON projects
+WHEN NEW.name IS NOT OLD.name
+BEGIN
+    INSERT OR REPLACE INTO project_aliases (alias, project_id) VALUES (OLD.name, NEW.id);
+    DELETE FROM project_aliases WHERE alias = NEW.name;
+END;
+
+CREATE TRIGGER IF NOT EXISTS projects_claim_alias AFTER INSERT ON projects
+BEGIN
+    DELETE FROM project_aliases WHERE alias = NEW.name;
+END;
+
+-- Legacy writers that only set topic follow aliases too
+DROP TRIGGER IF EXISTS bookmarks_topic_resolve_insert;
+DROP TRIGGER IF EXISTS bookmarks_topic_resolve_update;
+
+CREATE TRIGGER IF NOT EXISTS bookmarks_topic_resolve_insert AFTER INSERT ON bookmarks
+WHEN NEW.project_id IS NULL AND NEW.topic IS NOT NULL AND trim(NEW.topic) != ''
+BEGIN
+    INSERT OR IGNORE INTO projects (name, description, status, created_at, updated_at)
+    SELECT trim(NEW.topic), 'Auto-created for topic: ' || trim(NEW.topic), 'active', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+    WHERE NOT EXISTS (SELECT 1 FROM project_aliases WHERE alias = trim(NEW.topic));
+    UPDATE bookmarks SET project_id = COALESCE(
+        (SELECT id FROM projects WHERE name = trim(NEW.topic) COLLATE NOCASE),
+        (SELECT project_id FROM project_aliases WHERE alias = trim(NEW.topic))
+    ) WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS bookmarks_topic_resolve_update AFTER UPDATE OF topic, project_id ON bookmarks
+WHEN NEW.project_id IS NULL AND NEW.topic IS NOT NULL AND trim(NEW.topic) != ''
+BEGIN
+    INSERT OR IGNORE INTO projects (name, description, status, created_at, updated_at)
+    SELECT trim(NEW.topic), 'Auto-created for topic: ' || trim(NEW.topic), 'active', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+    WHERE NOT EXISTS (SELECT 1 FROM project_aliases WHERE alias = trim(NEW.topic));
+    UPDATE bookmarks SET project_id = COALESCE(
+        (SELECT id FROM projects WHERE name = trim(NEW.topic) COLLATE NOCASE),
+        (SELECT project_id FROM project_aliases WHERE alias = trim(NEW.topic))
+    ) WHERE id = NEW.id;
+END;
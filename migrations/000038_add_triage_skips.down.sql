@@ -0,0 +1,2 @@
+-- Drop triage skips
+DROP TABLE IF EXISTS triage_skips;
@@ -0,0 +1,11 @@
+-- Triage skips per session: a skip pushes the bookmark down that session's
+-- queue without changing its action, and skips add up across sessions
+CREATE TABLE IF NOT EXISTS triage_skips (
+    session TEXT NOT NULL,
+    bookmark_id INTEGER NOT NULL REFERENCES bookmarks(id),
+    skips INTEGER NOT NULL DEFAULT 1,
+    skipped_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (session, bookmark_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_triage_skips_bookmark ON triage_skips(bookmark_id);
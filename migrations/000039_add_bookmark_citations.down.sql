@@ -0,0 +1,2 @@
+-- Drop bookmark citations
+DROP TABLE IF EXISTS bookmark_citations;
@@ -0,0 +1,14 @@
+-- Citation metadata for academic bookmarks, extracted from the page's
+-- citation_* tags or edited by hand
+CREATE TABLE IF NOT EXISTS bookmark_citations (
+    bookmark_id INTEGER PRIMARY KEY REFERENCES bookmarks(id) ON DELETE CASCADE,
+    authors TEXT NOT NULL DEFAULT '[]',
+    year INTEGER,
+    venue TEXT,
+    doi TEXT,
+    arxiv_id TEXT,
+    source TEXT NOT NULL DEFAULT 'page',
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookmark_citations_doi ON bookmark_citations(doi);
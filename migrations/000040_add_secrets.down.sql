@@ -0,0 +1,2 @@
+-- Drop encrypted secrets
+DROP TABLE IF EXISTS secrets;
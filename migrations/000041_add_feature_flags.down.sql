@@ -0,0 +1,2 @@
+-- Drop runtime feature flag overrides
+DROP TABLE IF EXISTS feature_flags;
@@ -0,0 +1,6 @@
+-- Feature flags toggled at runtime; flags without a row use their configured value
+CREATE TABLE IF NOT EXISTS feature_flags (
+    name TEXT PRIMARY KEY,
+    enabled BOOLEAN NOT NULL,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
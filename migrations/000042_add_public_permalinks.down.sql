@@ -0,0 +1,5 @@
+-- Remove public permalinks
+DROP INDEX IF EXISTS idx_projects_slug;
+ALTER TABLE projects DROP COLUMN slug;
+ALTER TABLE projects DROP COLUMN public;
+ALTER TABLE bookmarks DROP COLUMN public;
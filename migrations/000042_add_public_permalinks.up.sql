@@ -0,0 +1,5 @@
+-- Bookmarks and projects marked public get permalink pages (/b/{id}, /p/{slug}) and are listed in sitemap.xml
+ALTER TABLE bookmarks ADD COLUMN public BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE projects ADD COLUMN public BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE projects ADD COLUMN slug TEXT;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_slug ON projects(slug);
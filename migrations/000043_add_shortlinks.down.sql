@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_shortlink_visits_bookmark;
+DROP TABLE IF EXISTS shortlink_visits;
+DROP TABLE IF EXISTS bookmark_shortlinks;
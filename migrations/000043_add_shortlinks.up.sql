@@ -0,0 +1,16 @@
+-- Short links for sharing bookmarks; each bookmark has at most one code
+CREATE TABLE IF NOT EXISTS bookmark_shortlinks (
+    code TEXT PRIMARY KEY,
+    bookmark_id INTEGER NOT NULL UNIQUE REFERENCES bookmarks(id) ON DELETE CASCADE,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One row per redirect; only the referring host is kept
+CREATE TABLE IF NOT EXISTS shortlink_visits (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    bookmark_id INTEGER NOT NULL REFERENCES bookmarks(id) ON DELETE CASCADE,
+    visited_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    referrer TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_shortlink_visits_bookmark ON shortlink_visits(bookmark_id, visited_at);
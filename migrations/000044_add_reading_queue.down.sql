@@ -0,0 +1 @@
+DROP TABLE IF EXISTS reading_queue;
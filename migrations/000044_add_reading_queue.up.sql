@@ -0,0 +1,9 @@
+-- Reading queue ordering for read-later bookmarks; bookmarks without a row
+-- are unpinned, priority 0 and not snoozed
+CREATE TABLE IF NOT EXISTS reading_queue (
+    bookmark_id INTEGER PRIMARY KEY REFERENCES bookmarks(id) ON DELETE CASCADE,
+    pinned BOOLEAN NOT NULL DEFAULT FALSE,
+    priority INTEGER NOT NULL DEFAULT 0,
+    snoozed_until DATETIME,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
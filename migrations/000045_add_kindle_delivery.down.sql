@@ -0,0 +1 @@
+ALTER TABLE reading_queue DROP COLUMN kindle_sent_at;
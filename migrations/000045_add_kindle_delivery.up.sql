@@ -0,0 +1,2 @@
+-- When a read-later bookmark was last emailed to the send-to-Kindle address
+ALTER TABLE reading_queue ADD COLUMN kindle_sent_at DATETIME;
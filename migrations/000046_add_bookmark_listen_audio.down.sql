@@ -0,0 +1,2 @@
+-- Remove bookmark listen audio
+ALTER TABLE bookmarks DROP COLUMN listen_attachment_id;
@@ -0,0 +1,2 @@
+-- Bookmarks can have a text-to-speech recording stored as one of their attachments
+ALTER TABLE bookmarks ADD COLUMN listen_attachment_id INTEGER;
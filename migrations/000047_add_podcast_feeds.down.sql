@@ -0,0 +1,2 @@
+-- Remove podcast feeds
+DROP TABLE IF EXISTS podcast_feeds;
@@ -0,0 +1,10 @@
+-- Private podcast feeds of the listen-later queue; the token in the feed URL
+-- is stored hashed like API tokens
+CREATE TABLE IF NOT EXISTS podcast_feeds (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    prefix TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    last_fetched_at DATETIME
+);
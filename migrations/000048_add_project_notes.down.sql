@@ -0,0 +1,4 @@
+-- Remove project notes
+DROP INDEX IF EXISTS idx_project_notes_rollup;
+DROP INDEX IF EXISTS idx_project_notes_project;
+DROP TABLE IF EXISTS project_notes;
@@ -0,0 +1,15 @@
+-- Notes on a project, including the weekly rollups written by the
+-- project-rollups job; emailed_at marks rollups already sent in a digest
+CREATE TABLE IF NOT EXISTS project_notes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL DEFAULT 'note',
+    title TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    period_start DATETIME,
+    emailed_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_notes_project ON project_notes(project_id, created_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_project_notes_rollup ON project_notes(project_id, period_start) WHERE kind = 'rollup';
@@ -0,0 +1,2 @@
+-- Remove the bookmark private flag
+ALTER TABLE bookmarks DROP COLUMN private;
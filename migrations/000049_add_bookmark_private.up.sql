@@ -0,0 +1,2 @@
+-- Private bookmarks stay out of public pages, feeds, digests and exports
+ALTER TABLE bookmarks ADD COLUMN private BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+-- Remove domain rules
+DROP TABLE IF EXISTS domain_rules;
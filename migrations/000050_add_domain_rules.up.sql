@@ -0,0 +1,11 @@
+-- Per-domain ingest rules: never save a domain, mark it irrelevant, or give
+-- its bookmarks a default action and project
+CREATE TABLE IF NOT EXISTS domain_rules (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    domain TEXT NOT NULL UNIQUE,
+    kind TEXT NOT NULL,
+    action TEXT,
+    project_id INTEGER REFERENCES projects(id) ON DELETE SET NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
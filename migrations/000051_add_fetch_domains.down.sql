@@ -0,0 +1,2 @@
+-- Remove content fetch domain settings
+DROP TABLE IF EXISTS fetch_domains;
@@ -0,0 +1,9 @@
+-- Per-domain settings for server-side content fetching. Cookies are sealed
+-- with the secrets key; domains without a row are fetched without either.
+CREATE TABLE IF NOT EXISTS fetch_domains (
+    domain TEXT PRIMARY KEY,
+    cookies BLOB,
+    use_browser BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
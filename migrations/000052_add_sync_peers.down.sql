@@ -0,0 +1,3 @@
+-- Remove paired instance sync
+DROP TABLE IF EXISTS sync_peer_bookmarks;
+DROP TABLE IF EXISTS sync_peers;
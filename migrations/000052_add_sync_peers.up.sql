@@ -0,0 +1,35 @@
+-- Paired instances that selected projects are synced with. pull_rev and
+-- push_rev are the peer's and this instance's revisions synced up to.
+CREATE TABLE IF NOT EXISTS sync_peers (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    url TEXT NOT NULL,
+    token TEXT NOT NULL,
+    projects TEXT NOT NULL DEFAULT '[]',
+    conflict_rule TEXT NOT NULL DEFAULT 'newest',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    pull_rev INTEGER NOT NULL DEFAULT 0,
+    push_rev INTEGER NOT NULL DEFAULT 0,
+    last_sync_at DATETIME,
+    last_status TEXT NOT NULL DEFAULT '',
+    last_error TEXT NOT NULL DEFAULT '',
+    last_pulled INTEGER NOT NULL DEFAULT 0,
+    last_pushed INTEGER NOT NULL DEFAULT 0,
+    last_conflicts INTEGER NOT NULL DEFAULT 0,
+    last_errors INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Each synced bookmark's uuid and revision on both sides after it was last
+-- synced, to tell edits from echoes of the previous run
+CREATE TABLE IF NOT EXISTS sync_peer_bookmarks (
+    peer_id INTEGER NOT NULL REFERENCES sync_peers(id) ON DELETE CASCADE,
+    local_uuid TEXT NOT NULL,
+    remote_uuid TEXT NOT NULL,
+    local_rev INTEGER NOT NULL DEFAULT 0,
+    remote_rev INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (peer_id, local_uuid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_sync_peer_bookmarks_remote ON sync_peer_bookmarks(peer_id, remote_uuid);
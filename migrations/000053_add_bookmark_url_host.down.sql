@@ -0,0 +1,3 @@
+-- Remove bookmark hosts
+DROP INDEX IF EXISTS idx_bookmarks_url_host;
+ALTER TABLE bookmarks DROP COLUMN url_host;
@@ -0,0 +1,16 @@
+-- Each bookmark's host, lowercased and without "www.", so lookups by host can
+-- use an index. The application sets it on save; existing rows are filled in
+-- step by step: drop the scheme, then anything after the host, then userinfo,
+-- the port and "www.".
+ALTER TABLE bookmarks ADD COLUMN url_host TEXT;
+
+UPDATE bookmarks SET url_host = LOWER(SUBSTR(url, INSTR(url, '://') + 3)) WHERE INSTR(url, '://') > 0;
+UPDATE bookmarks SET url_host = SUBSTR(url_host, 1, INSTR(url_host, '/') - 1) WHERE INSTR(url_host, '/') > 0;
+UPDATE bookmarks SET url_host = SUBSTR(url_host, 1, INSTR(url_host, '?') - 1) WHERE INSTR(url_host, '?') > 0;
+UPDATE bookmarks SET url_host = SUBSTR(url_host, 1, INSTR(url_host, '#') - 1) WHERE INSTR(url_host, '#') > 0;
+UPDATE bookmarks SET url_host = SUBSTR(url_host, INSTR(url_host, '@') + 1) WHERE INSTR(url_host, '@') > 0;
+UPDATE bookmarks SET url_host = SUBSTR(url_host, 1, INSTR(url_host, ':') - 1) WHERE INSTR(url_host, ':') > 0 AND url_host NOT LIKE '[%';
+UPDATE bookmarks SET url_host = SUBSTR(url_host, 2, INSTR(url_host, ']') - 2) WHERE url_host LIKE '[%]%';
+UPDATE bookmarks SET url_host = SUBSTR(url_host, 5) WHERE url_host LIKE 'www.%';
+
+CREATE INDEX IF NOT EXISTS idx_bookmarks_url_host ON bookmarks(url_host);
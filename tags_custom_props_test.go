@@ -161,7 +161,7 @@ func TestCreateBookmarkWithTagsAndProps(t *testing.T) {
 		},
 	}
 
-	err = saveBookmarkToDB(bookmark)
+	_, err = saveBookmarkToDB(bookmark)
 	if err != nil {
 		t.Fatalf("saveBookmarkToDB failed: %v", err)
 	}
@@ -235,7 +235,7 @@ func TestUpdateBookmarkWithTagsAndProps(t *testing.T) {
 		},
 	}
 
-	err = saveBookmarkToDB(initial)
+	_, err = saveBookmarkToDB(initial)
 	if err != nil {
 		t.Fatalf("saveBookmarkToDB failed: %v", err)
 	}
@@ -333,8 +333,8 @@ func TestBookmarkAPIWithTagsAndProps(t *testing.T) {
 	w := httptest.NewRecorder()
 	handleBookmark(w, req)
 
-	if w.Code != http.StatusOK {
-		t.Errorf("Expected status 200, got %d. Body: %s", w.Code, w.Body.String())
+	if w.Code != http.StatusCreated {
+		t.Errorf("Expected status 201, got %d. Body: %s", w.Code, w.Body.String())
 	}
 
 	// Parse response
@@ -385,7 +385,8 @@ func createTestTablesWithMigrations(testDB *sql.DB) error {
 			description TEXT,
 			status TEXT DEFAULT 'active',
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			version INTEGER DEFAULT 1
 		)`,
 		// Migration 3: Add project_id column
 		`ALTER TABLE bookmarks ADD COLUMN project_id INTEGER REFERENCES projects(id)`,
@@ -394,6 +395,105 @@ func createTestTablesWithMigrations(testDB *sql.DB) error {
 		`ALTER TABLE bookmarks ADD COLUMN custom_properties TEXT DEFAULT '{}'`,
 		// Migration 6: Add deleted column for soft delete
 		`ALTER TABLE bookmarks ADD COLUMN deleted BOOLEAN DEFAULT FALSE`,
+		// Migration 8: Add sync tracking columns
+		`ALTER TABLE bookmarks ADD COLUMN uuid TEXT`,
+		`ALTER TABLE bookmarks ADD COLUMN rev INTEGER DEFAULT 0`,
+		`ALTER TABLE bookmarks ADD COLUMN updated_at DATETIME`,
+		testSyncSchemaSQL,
+		// Migration 10: Link topics to projects
+		testProjectLinkSchemaSQL,
+		// Migration 11: Share targets
+		testShareTargetsSchemaSQL,
+		// Migration 12: Add shared_at
+		`ALTER TABLE bookmarks ADD COLUMN shared_at DATETIME`,
+		// Migration 13: Project soft delete
+		`ALTER TABLE projects ADD COLUMN deleted_at DATETIME`,
+		`ALTER TABLE bookmarks ADD COLUMN trashed_project_id INTEGER`,
+		// Migration 15: Wayback snapshots
+		`ALTER TABLE bookmarks ADD COLUMN wayback_url TEXT`,
+		`ALTER TABLE bookmarks ADD COLUMN wayback_at DATETIME`,
+		// Migration 16: Bookmark summaries
+		`ALTER TABLE bookmarks ADD COLUMN summary TEXT`,
+		// Migration 17: Project snapshots
+		testProjectSnapshotsSchemaSQL,
+		// Migration 18: API tokens
+		testAPITokensSchemaSQL,
+		// Migration 19: Audit log
+		testAuditLogSchemaSQL,
+		// Migration 20: On-disk content
+		`ALTER TABLE bookmarks ADD COLUMN content_path TEXT`,
+		// Migration 21: Bookmark attachments
+		testAttachmentsSchemaSQL,
+		// Migration 22: Thumbnails
+		`ALTER TABLE bookmarks ADD COLUMN thumbnail_key TEXT`,
+		`ALTER TABLE bookmarks ADD COLUMN thumbnail_type TEXT`,
+		// Migration 23: Project appearance
+		`ALTER TABLE projects ADD COLUMN color TEXT`,
+		`ALTER TABLE projects ADD COLUMN cover_key TEXT`,
+		`ALTER TABLE projects ADD COLUMN cover_type TEXT`,
+		// Migration 24: Triage aging
+		testTriageAgingSchemaSQL,
+		// Migration 25: Bookmark sources
+		`ALTER TABLE bookmarks ADD COLUMN source TEXT`,
+		// Migration 26: Bookmark clients
+		`ALTER TABLE bookmarks ADD COLUMN client TEXT`,
+		// Migration 27: Suggestion feedback
+		testSuggestionFeedbackSchemaSQL,
+		// Migration 28: Suggestion classifier
+		testSuggestionModelSchemaSQL,
+		// Migration 29: Project activity
+		testProjectEventsSchemaSQL,
+		// Migration 30: Additional bookmark projects
+		testBookmarkProjectsSchemaSQL,
+		// Migration 31: Bookmark relations
+		testBookmarkRelationsSchemaSQL,
+		// Migration 32: Board positions
+		testBoardPositionsSchemaSQL,
+		// Migration 33: UI preferences
+		testUIPreferencesSchemaSQL,
+		// Migration 34: Quick-capture inbox
+		testCapturesSchemaSQL,
+		// Migration 35: Bookmark quotes
+		`ALTER TABLE bookmarks ADD COLUMN quote TEXT`,
+		// Migration 36: Normalized project names
+		testProjectNamesSchemaSQL,
+		// Migration 37: Project aliases
+		testProjectAliasesSchemaSQL,
+		// Migration 38: Triage skips
+		testTriageSkipsSchemaSQL,
+		// Migration 39: Bookmark citations
+		testBookmarkCitationsSchemaSQL,
+		// Migration 40: Encrypted secrets
+		testSecretsSchemaSQL,
+		// Migration 41: Feature flags
+		testFeatureFlagsSchemaSQL,
+		// Migration 42: Public permalinks
+		`ALTER TABLE bookmarks ADD COLUMN public BOOLEAN NOT NULL DEFAULT FALSE`,
+		`ALTER TABLE projects ADD COLUMN public BOOLEAN NOT NULL DEFAULT FALSE`,
+		`ALTER TABLE projects ADD COLUMN slug TEXT`,
+		testPublicPermalinksSchemaSQL,
+		// Migration 43: Short links
+		testShortlinksSchemaSQL,
+		// Migration 44: Reading queue
+		testReadingQueueSchemaSQL,
+		// Migration 45: Kindle delivery
+		testKindleDeliverySchemaSQL,
+		// Migration 46: Bookmark listen audio
+		`ALTER TABLE bookmarks ADD COLUMN listen_attachment_id INTEGER`,
+		// Migration 47: Podcast feeds
+		testPodcastFeedsSchemaSQL,
+		// Migration 48: Project notes
+		testProjectNotesSchemaSQL,
+		// Migration 49: Bookmark private flag
+		`ALTER TABLE bookmarks ADD COLUMN private BOOLEAN NOT NULL DEFAULT FALSE`,
+		// Migration 50: Domain rules
+		testDomainRulesSchemaSQL,
+		// Migration 51: Content fetch domains
+		testFetchDomainsSchemaSQL,
+		// Migration 52: Sync peers
+		testSyncPeersSchemaSQL,
+		// Migration 53: Bookmark hosts
+		`ALTER TABLE bookmarks ADD COLUMN url_host TEXT`,
 	}
 
 	for i, migration := range migrations {